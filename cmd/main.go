@@ -10,6 +10,8 @@ import (
 
 func main() {
 	funcframework.RegisterHTTPFunction("/", functions.HandleImport)
+	funcframework.RegisterHTTPFunction("/_/update", functions.HandleUpdate)
+	funcframework.RegisterHTTPFunction("/_/api/packages", functions.HandleAPI)
 	// Use PORT environment variable, or default to 8080.
 	port := "8080"
 	if envPort := os.Getenv("LOCAL_PORT"); envPort != "" {
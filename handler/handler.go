@@ -17,6 +17,8 @@ package handler
 
 import (
 	"context"
+	_ "embed"
+	"encoding/json"
 	"fmt"
 	"html/template"
 	"io/ioutil"
@@ -25,6 +27,7 @@ import (
 	"os"
 	"sort"
 	"strings"
+	"sync"
 	"time"
 
 	"gopkg.in/yaml.v2"
@@ -32,20 +35,39 @@ import (
 
 // config is the vanity config
 type config struct {
-	Host     string `yaml:"host,omitempty"`
-	CacheAge *int64 `yaml:"cache_max_age,omitempty"`
-	Paths    map[string]struct {
-		Repo    string `yaml:"repo,omitempty"`
-		Display string `yaml:"display,omitempty"`
-		VCS     string `yaml:"vcs,omitempty"`
+	Host            string `yaml:"host,omitempty"`
+	CacheAge        *int64 `yaml:"cache_max_age,omitempty"`
+	DefaultFormat   string `yaml:"default_format,omitempty"`
+	RefreshInterval string `yaml:"refresh_interval,omitempty"`
+	TemplatesURL    string `yaml:"templates_url,omitempty"`
+	DefaultBranch   string `yaml:"default_branch,omitempty"`
+	Paths           map[string]struct {
+		Repo          string   `yaml:"repo,omitempty"`
+		Display       string   `yaml:"display,omitempty"`
+		VCS           string   `yaml:"vcs,omitempty"`
+		Topics        []string `yaml:"topics,omitempty"`
+		DefaultBranch string   `yaml:"default_branch,omitempty"`
+		VCSHost       string   `yaml:"vcs_host,omitempty"`
 	} `yaml:"paths,omitempty"`
+	Topics map[string]struct {
+		Description string `yaml:"description,omitempty"`
+		Order       int    `yaml:"order,omitempty"`
+	} `yaml:"topics,omitempty"`
 }
 
 // Handler is the request handler.
 type Handler struct {
-	host         string
-	cacheControl string
-	paths        pathConfigSet
+	mu              sync.RWMutex
+	host            string
+	cacheControl    string
+	paths           pathConfigSet
+	topics          []topicConfig
+	defaultFormat   string
+	refreshInterval time.Duration
+	lastModified    time.Time
+	indexTemplate   *template.Template
+	vanityTemplate  *template.Template
+	packageTemplate *template.Template
 }
 
 type pathConfigSet []pathConfig
@@ -55,32 +77,62 @@ type pathConfig struct {
 	repo    string
 	display string
 	vcs     string
+	topics  []string
+}
+
+// topicConfig describes a topic heading used to group packages on the index page.
+type topicConfig struct {
+	name        string
+	description string
+	order       int
+}
+
+// topicView is the data passed to indexTemplate for a single topic heading.
+type topicView struct {
+	Name        string
+	Description string
+	Packages    []string
 }
 
 // handler is the vanity imports handler.
 // Making this global allows for caching of the handler state.
-var handler Handler
-
-var indexTemplate = template.Must(template.New("index").Parse(`<!DOCTYPE html>
-<html>
-<h1>Welcome to {{.Host}}</h1>
-<ul>
-{{range .Handlers}}<li><a href="https://pkg.go.dev/{{.}}">{{.}}</a></li>{{end}}
-</ul>
-</html>
-`))
-
-var vanityTemplate = template.Must(template.New("vanity").Parse(`<!DOCTYPE html>
-<html>
-<head>
-<meta http-equiv="Content-Type" content="text/html; charset=utf-8"/>
-<meta name="go-import" content="{{.Import}} {{.VCS}} {{.Repo}}">
-<meta name="go-source" content="{{.Import}} {{.Display}}">
-</head>
-<body>
-Nothing to see here folks!
-</body>
-</html>`))
+// The embedded templates are used until/unless InitHandler loads overrides from templates_url.
+var handler = Handler{
+	indexTemplate:   defaultIndexTemplate,
+	vanityTemplate:  defaultVanityTemplate,
+	packageTemplate: defaultPackageTemplate,
+}
+
+//go:embed templates/index.tmpl
+var defaultIndexTemplateSrc string
+
+//go:embed templates/vanity.tmpl
+var defaultVanityTemplateSrc string
+
+//go:embed templates/package.tmpl
+var defaultPackageTemplateSrc string
+
+// funcMap is exposed to both the embedded default templates and any override templates
+// fetched from templates_url, so overrides can use helpers like `{{ hasTopic .Topics "foo" }}`.
+var funcMap = template.FuncMap{
+	"hasTopic": hasTopic,
+}
+
+// hasTopic reports whether topics contains topic.
+func hasTopic(topics []string, topic string) bool {
+	for _, t := range topics {
+		if t == topic {
+			return true
+		}
+	}
+	return false
+}
+
+var defaultIndexTemplate = template.Must(template.New("index").Funcs(funcMap).Parse(defaultIndexTemplateSrc))
+
+var defaultVanityTemplate = template.Must(template.New("vanity").Funcs(funcMap).Parse(defaultVanityTemplateSrc))
+
+var defaultPackageTemplate = template.Must(template.New("package").Funcs(funcMap).Parse(defaultPackageTemplateSrc))
 
 // client is a standard http client.
 // Making this global means that we can use connection pooling to reduce the creation of a new HTTP handlers for each function invocation.
@@ -88,75 +140,275 @@ var client = &http.Client{
 	Timeout: 10 * time.Second,
 }
 
-// InitHandler initializes the global handler.
-// This is non-idiomatic but is optimised for google cloud functions.
-// The config is parsed from a yaml file
-// - Fetched from an external source (GoogleCloudStorage/Github).
-func InitHandler(ctx context.Context, configURL string) error {
+// loadedConfig is the result of fetching and parsing the remote YAML config.
+// It is built up without touching the global handler so that a failed or in-flight
+// refresh never leaves the handler in a partially-updated state.
+type loadedConfig struct {
+	host            string
+	cacheControl    string
+	paths           pathConfigSet
+	topics          []topicConfig
+	defaultFormat   string
+	refreshInterval time.Duration
+	indexTemplate   *template.Template
+	vanityTemplate  *template.Template
+	packageTemplate *template.Template
+}
+
+// fetchConfig fetches configURL and parses it into a loadedConfig.
+func fetchConfig(configURL string) (*loadedConfig, error) {
 	// Fetch the config file from the remote path
 	res, err := client.Get(configURL)
 	if err != nil {
 		log.Printf("Could not fetch config file: %v\n", err)
-		return err
+		return nil, err
 	}
 	if res.StatusCode != http.StatusOK {
 		log.Printf("Could not fetch config file: %v\n", res.StatusCode)
-		return fmt.Errorf("could not fetch config file: %v", res.StatusCode)
+		return nil, fmt.Errorf("could not fetch config file: %v", res.StatusCode)
 	}
 	// Read out the configuration
 	raw, err := ioutil.ReadAll(res.Body)
 	if err != nil {
 		log.Printf("Could not read config file: %v\n", err)
-		return fmt.Errorf("could not read config file: %v", err)
+		return nil, fmt.Errorf("could not read config file: %v", err)
 	}
 	if len(raw) == 0 {
 		log.Println("Found empty config file")
-		return fmt.Errorf("found empty config file")
+		return nil, fmt.Errorf("found empty config file")
 	}
 
 	// Parse the yaml
 	var config config
 	if err := yaml.Unmarshal(raw, &config); err != nil {
 		log.Printf("Could not parse config: %v\n", err)
-		return fmt.Errorf("could not parse config: %v", err)
+		return nil, fmt.Errorf("could not parse config: %v", err)
 	}
 
-	handler.host = config.Host
+	loaded := &loadedConfig{host: config.Host}
 	cacheAge := int64(86400) // 24 hours (in seconds)
 	if config.CacheAge != nil {
 		cacheAge = *config.CacheAge
 		if cacheAge < 0 {
-			return fmt.Errorf("cache_max_age is negative")
+			return nil, fmt.Errorf("cache_max_age is negative")
 		}
 	}
-	handler.cacheControl = fmt.Sprintf("public, max-age=%d", cacheAge)
+	loaded.cacheControl = fmt.Sprintf("public, max-age=%d", cacheAge)
+
+	loaded.defaultFormat = config.DefaultFormat
+	if loaded.defaultFormat == "" {
+		loaded.defaultFormat = os.Getenv("INDEX_FORMAT")
+	}
+	if loaded.defaultFormat != "topics" {
+		loaded.defaultFormat = "list"
+	}
+
+	refreshIntervalStr := config.RefreshInterval
+	if refreshIntervalStr == "" {
+		refreshIntervalStr = os.Getenv("REFRESH_INTERVAL")
+	}
+	if refreshIntervalStr != "" {
+		loaded.refreshInterval, err = time.ParseDuration(refreshIntervalStr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid refresh_interval %q: %v", refreshIntervalStr, err)
+		}
+	}
+
+	for name, t := range config.Topics {
+		loaded.topics = append(loaded.topics, topicConfig{
+			name:        name,
+			description: t.Description,
+			order:       t.Order,
+		})
+	}
+	sort.Slice(loaded.topics, func(i, j int) bool {
+		if loaded.topics[i].order != loaded.topics[j].order {
+			return loaded.topics[i].order < loaded.topics[j].order
+		}
+		return loaded.topics[i].name < loaded.topics[j].name
+	})
+
 	for path, e := range config.Paths {
 		pc := pathConfig{
 			path:    strings.TrimSuffix(path, "/"),
 			repo:    e.Repo,
 			display: e.Display,
 			vcs:     e.VCS,
+			topics:  e.Topics,
+		}
+		branch := e.DefaultBranch
+		if branch == "" {
+			branch = config.DefaultBranch
 		}
+		if branch == "" {
+			branch = "main"
+		}
+
+		vcsHost := e.VCSHost
+		switch vcsHost {
+		case "":
+			switch {
+			case strings.HasPrefix(e.Repo, "https://github.com/"):
+				vcsHost = "github"
+			case strings.HasPrefix(e.Repo, "https://bitbucket.org"):
+				vcsHost = "bitbucket"
+			case strings.HasPrefix(e.Repo, "https://gitlab.com/"):
+				vcsHost = "gitlab"
+			case strings.HasPrefix(e.Repo, "https://git.sr.ht/"):
+				vcsHost = "sourcehut"
+			case strings.HasPrefix(e.Repo, "https://codeberg.org/"):
+				vcsHost = "gitea"
+			case strings.Contains(e.Repo, "/gitea/"):
+				vcsHost = "gitea"
+			}
+		case "github", "bitbucket", "gitlab", "sourcehut", "gitea":
+		default:
+			return nil, fmt.Errorf("configuration for %v: unknown vcs_host %q", path, e.VCSHost)
+		}
+
 		switch {
 		case e.Display != "":
-		case strings.HasPrefix(e.Repo, "https://github.com/"):
-			pc.display = fmt.Sprintf("%v %v/tree/master{/dir} %v/blob/master{/dir}/{file}#L{line}", e.Repo, e.Repo, e.Repo)
-		case strings.HasPrefix(e.Repo, "https://bitbucket.org"):
+		case vcsHost == "github":
+			pc.display = fmt.Sprintf("%v %v/tree/%v{/dir} %v/blob/%v{/dir}/{file}#L{line}", e.Repo, e.Repo, branch, e.Repo, branch)
+		case vcsHost == "bitbucket":
 			pc.display = fmt.Sprintf("%v %v/src/default{/dir} %v/src/default{/dir}/{file}#{file}-{line}", e.Repo, e.Repo, e.Repo)
+		case vcsHost == "gitlab":
+			pc.display = fmt.Sprintf("%v %v/-/tree/%v{/dir} %v/-/blob/%v{/dir}/{file}#L{line}", e.Repo, e.Repo, branch, e.Repo, branch)
+		case vcsHost == "sourcehut":
+			pc.display = fmt.Sprintf("%v %v/tree/%v/item{/dir} %v/tree/%v/item{/dir}/{file}#L{line}", e.Repo, e.Repo, branch, e.Repo, branch)
+		case vcsHost == "gitea":
+			pc.display = fmt.Sprintf("%v %v/src/branch/%v{/dir} %v/src/branch/%v{/dir}/{file}#L{line}", e.Repo, e.Repo, branch, e.Repo, branch)
+		default:
+			return nil, fmt.Errorf("configuration for %v: cannot infer display URL from %s, set display or vcs_host", path, e.Repo)
 		}
+
 		switch {
 		case e.VCS != "":
 			if e.VCS != "bzr" && e.VCS != "git" && e.VCS != "hg" && e.VCS != "svn" {
-				return fmt.Errorf("configuration for %v: unknown VCS %s", path, e.VCS)
+				return nil, fmt.Errorf("configuration for %v: unknown VCS %s", path, e.VCS)
 			}
-		case strings.HasPrefix(e.Repo, "https://github.com/"):
+		case vcsHost == "github", vcsHost == "gitlab", vcsHost == "sourcehut", vcsHost == "gitea":
 			pc.vcs = "git"
 		default:
-			return fmt.Errorf("configuration for %v: cannot infer VCS from %s", path, e.Repo)
+			return nil, fmt.Errorf("configuration for %v: cannot infer VCS from %s", path, e.Repo)
+		}
+		loaded.paths = append(loaded.paths, pc)
+	}
+	sort.Sort(loaded.paths)
+
+	loaded.indexTemplate = defaultIndexTemplate
+	loaded.vanityTemplate = defaultVanityTemplate
+	loaded.packageTemplate = defaultPackageTemplate
+	templatesURL := config.TemplatesURL
+	if templatesURL == "" {
+		templatesURL = os.Getenv("TEMPLATES_URL")
+	}
+	if templatesURL != "" {
+		if tmpl, err := fetchOverrideTemplate(templatesURL, "index.tmpl", "index"); err != nil {
+			log.Printf("Could not load override index template, using default: %v\n", err)
+		} else {
+			loaded.indexTemplate = tmpl
+		}
+		if tmpl, err := fetchOverrideTemplate(templatesURL, "vanity.tmpl", "vanity"); err != nil {
+			log.Printf("Could not load override vanity template, using default: %v\n", err)
+		} else {
+			loaded.vanityTemplate = tmpl
+		}
+		if tmpl, err := fetchOverrideTemplate(templatesURL, "package.tmpl", "package"); err != nil {
+			log.Printf("Could not load override package template, using default: %v\n", err)
+		} else {
+			loaded.packageTemplate = tmpl
 		}
-		handler.paths = append(handler.paths, pc)
 	}
-	sort.Sort(handler.paths)
+
+	return loaded, nil
+}
+
+// fetchOverrideTemplate fetches file relative to templatesURL and parses it as an override for
+// the named template, with funcMap applied. Callers fall back to the embedded default on error.
+func fetchOverrideTemplate(templatesURL, file, name string) (*template.Template, error) {
+	res, err := client.Get(strings.TrimSuffix(templatesURL, "/") + "/" + file)
+	if err != nil {
+		return nil, err
+	}
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("could not fetch %s: %v", file, res.StatusCode)
+	}
+	raw, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		return nil, err
+	}
+	if len(raw) == 0 {
+		return nil, fmt.Errorf("found empty override template %s", file)
+	}
+	return template.New(name).Funcs(funcMap).Parse(string(raw))
+}
+
+// apply atomically swaps loaded into the global handler.
+func (loaded *loadedConfig) apply() {
+	handler.mu.Lock()
+	defer handler.mu.Unlock()
+	handler.host = loaded.host
+	handler.cacheControl = loaded.cacheControl
+	handler.paths = loaded.paths
+	handler.topics = loaded.topics
+	handler.defaultFormat = loaded.defaultFormat
+	handler.refreshInterval = loaded.refreshInterval
+	handler.indexTemplate = loaded.indexTemplate
+	handler.vanityTemplate = loaded.vanityTemplate
+	handler.packageTemplate = loaded.packageTemplate
+	handler.lastModified = time.Now()
+}
+
+// configURL is the remote config location, kept around so HandleUpdate can trigger an
+// out-of-band reload. Like the global handler, this is set once at cold start / InitHandler
+// time and is not expected to change concurrently.
+var configURL string
+
+// InitHandler initializes the global handler.
+// This is non-idiomatic but is optimised for google cloud functions.
+// The config is parsed from a yaml file
+// - Fetched from an external source (GoogleCloudStorage/Github).
+// If the config sets a positive refresh_interval (or, when the config has none, REFRESH_INTERVAL
+// does), a background goroutine re-fetches and swaps the config on that interval until ctx is done.
+func InitHandler(ctx context.Context, url string) error {
+	loaded, err := fetchConfig(url)
+	if err != nil {
+		return err
+	}
+	loaded.apply()
+	configURL = url
+
+	if loaded.refreshInterval > 0 {
+		go refreshLoop(ctx, url, loaded.refreshInterval)
+	}
+	return nil
+}
+
+// refreshLoop re-fetches url every interval and swaps the result into the global handler,
+// until ctx is done. Fetch errors are logged and the previous configuration is kept.
+func refreshLoop(ctx context.Context, url string, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := reload(url); err != nil {
+				log.Printf("Could not refresh config: %v\n", err)
+			}
+		}
+	}
+}
+
+// reload immediately re-fetches url and swaps the result into the global handler.
+func reload(url string) error {
+	loaded, err := fetchConfig(url)
+	if err != nil {
+		return err
+	}
+	loaded.apply()
 	return nil
 }
 
@@ -168,7 +420,14 @@ func init() {
 // HandleImport handles Go's vanity import requests.
 func HandleImport(w http.ResponseWriter, r *http.Request) {
 	current := r.URL.Path
+
+	handler.mu.RLock()
 	pc, subpath := handler.paths.find(current)
+	cacheControl := handler.cacheControl
+	vanityTmpl := handler.vanityTemplate
+	packageTmpl := handler.packageTemplate
+	handler.mu.RUnlock()
+
 	if pc == nil && current == "/" {
 		handler.serveIndex(w, r)
 		return
@@ -178,51 +437,193 @@ func HandleImport(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	w.Header().Set("Cache-Control", handler.cacheControl)
-	if err := vanityTemplate.Execute(w, struct {
+	// The go tool always sets go-get=1; anything else is a human browsing the page, who gets
+	// the richer package page instead. Both templates carry the go-import/go-source meta tags,
+	// so pasting the browser URL into `go get` keeps working either way.
+	tmpl := packageTmpl
+	if r.URL.Query().Get("go-get") == "1" {
+		tmpl = vanityTmpl
+	}
+
+	w.Header().Set("Cache-Control", cacheControl)
+	if err := tmpl.Execute(w, struct {
 		Import  string
 		Subpath string
 		Repo    string
 		Display string
 		VCS     string
+		Topics  []string
 	}{
 		Import:  handler.Host(r) + pc.path,
 		Subpath: subpath,
 		Repo:    pc.repo,
 		Display: pc.display,
 		VCS:     pc.vcs,
+		Topics:  pc.topics,
 	}); err != nil {
 		http.Error(w, "cannot render the page", http.StatusInternalServerError)
 	}
 }
 
+// HandleAPI returns the loaded path config as JSON, so external tooling (dashboards, doc
+// generators) can consume it without re-parsing the YAML config.
+func HandleAPI(w http.ResponseWriter, r *http.Request) {
+	handler.mu.RLock()
+	cacheControl := handler.cacheControl
+	paths := handler.paths
+	handler.mu.RUnlock()
+
+	type packageInfo struct {
+		Path    string   `json:"path"`
+		Repo    string   `json:"repo"`
+		VCS     string   `json:"vcs"`
+		Display string   `json:"display"`
+		Topics  []string `json:"topics,omitempty"`
+	}
+	packages := make([]packageInfo, len(paths))
+	for i, pc := range paths {
+		packages[i] = packageInfo{
+			Path:    pc.path,
+			Repo:    pc.repo,
+			VCS:     pc.vcs,
+			Display: pc.display,
+			Topics:  pc.topics,
+		}
+	}
+
+	w.Header().Set("Cache-Control", cacheControl)
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(packages); err != nil {
+		http.Error(w, "cannot render the response", http.StatusInternalServerError)
+	}
+}
+
 // serveIndex serves the list of all supported paths for this host.
+// The `format` query parameter selects between the flat `list` view and the `topics` view;
+// it defaults to the handler's configured default format.
 func (h *Handler) serveIndex(w http.ResponseWriter, r *http.Request) {
 	host := h.Host(r)
-	handlers := make([]string, len(h.paths))
-	for i, h := range h.paths {
-		handlers[i] = host + h.path
+
+	h.mu.RLock()
+	paths := h.paths
+	topics := h.topics
+	defaultFormat := h.defaultFormat
+	indexTmpl := h.indexTemplate
+	h.mu.RUnlock()
+
+	format := r.URL.Query().Get("format")
+	if format != "list" && format != "topics" {
+		format = defaultFormat
 	}
-	if err := indexTemplate.Execute(w, struct {
+
+	data := struct {
 		Host     string
+		Format   string
 		Handlers []string
+		Topics   []topicView
 	}{
-		Host:     host,
-		Handlers: handlers,
-	}); err != nil {
+		Host:   host,
+		Format: format,
+	}
+
+	if format == "topics" {
+		grouped := make(map[string][]string)
+		var other []string
+		for _, pc := range paths {
+			full := host + pc.path
+			if len(pc.topics) == 0 {
+				other = append(other, full)
+				continue
+			}
+			for _, t := range pc.topics {
+				grouped[t] = append(grouped[t], full)
+			}
+		}
+		for _, tc := range topics {
+			pkgs, ok := grouped[tc.name]
+			if !ok {
+				continue
+			}
+			data.Topics = append(data.Topics, topicView{Name: tc.name, Description: tc.description, Packages: pkgs})
+			delete(grouped, tc.name)
+		}
+		// Topics referenced by a path's `topics` list but missing from the top-level
+		// `topics` section still get a heading, in alphabetical order after the configured ones.
+		var undeclared []string
+		for name := range grouped {
+			undeclared = append(undeclared, name)
+		}
+		sort.Strings(undeclared)
+		for _, name := range undeclared {
+			data.Topics = append(data.Topics, topicView{Name: name, Packages: grouped[name]})
+		}
+		if len(other) > 0 {
+			data.Topics = append(data.Topics, topicView{Name: "Other", Packages: other})
+		}
+	} else {
+		data.Handlers = make([]string, len(paths))
+		for i, pc := range paths {
+			data.Handlers[i] = host + pc.path
+		}
+	}
+
+	if err := indexTmpl.Execute(w, data); err != nil {
 		http.Error(w, "cannot render the page", http.StatusInternalServerError)
 	}
 }
 
 // Host returns a the host.
 func (h *Handler) Host(r *http.Request) string {
+	h.mu.RLock()
 	host := h.host
+	h.mu.RUnlock()
 	if host == "" {
 		return r.Host
 	}
 	return host
 }
 
+// HandleUpdate triggers an immediate reload of the config from the configured URL.
+// It requires a bearer token matching the UPDATE_TOKEN environment variable, and is
+// rate-limited to at most once per refresh_interval to avoid hammering configURL.
+func HandleUpdate(w http.ResponseWriter, r *http.Request) {
+	token := os.Getenv("UPDATE_TOKEN")
+	if token == "" || r.Header.Get("Authorization") != "Bearer "+token {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	handler.mu.RLock()
+	interval := handler.refreshInterval
+	last := handler.lastModified
+	handler.mu.RUnlock()
+
+	if interval > 0 && time.Since(last) < interval {
+		http.Error(w, "update rate limit exceeded, try again later", http.StatusTooManyRequests)
+		return
+	}
+
+	if err := reload(configURL); err != nil {
+		http.Error(w, fmt.Sprintf("could not reload config: %v", err), http.StatusInternalServerError)
+		return
+	}
+
+	handler.mu.RLock()
+	resp := struct {
+		Paths        int       `json:"paths"`
+		LastModified time.Time `json:"last_modified"`
+	}{
+		Paths:        len(handler.paths),
+		LastModified: handler.lastModified,
+	}
+	handler.mu.RUnlock()
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		http.Error(w, "cannot render the response", http.StatusInternalServerError)
+	}
+}
+
 func (pset pathConfigSet) Len() int {
 	return len(pset)
 }